@@ -0,0 +1,237 @@
+package resources
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/bennylu2/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var grantsSchema = map[string]*schema.Schema{
+	"grants_on": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  "Lists all privileges that have been granted on an object.",
+		ExactlyOneOf: []string{"grants_on", "grants_to", "grants_of", "future_grants_in"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of object to list privileges on. Omit when object_type is ACCOUNT.",
+				},
+				"object_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Type of object to list privileges on, e.g. DATABASE, SCHEMA, TABLE, ACCOUNT.",
+				},
+			},
+		},
+	},
+	"grants_to": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  "Lists all privileges granted to the grantee.",
+		ExactlyOneOf: []string{"grants_on", "grants_to", "grants_of", "future_grants_in"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"grantee_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Type of the grantee. Valid values are ROLE, USER, SHARE, APPLICATION, APPLICATION ROLE.",
+				},
+				"grantee_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the grantee.",
+				},
+			},
+		},
+	},
+	"grants_of": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  "Lists all grantees that have been granted a role, share, or application role.",
+		ExactlyOneOf: []string{"grants_on", "grants_to", "grants_of", "future_grants_in"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"grantee_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Type of the grantee. Valid values are ROLE, SHARE, APPLICATION ROLE.",
+				},
+				"grantee_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Name of the grantee.",
+				},
+			},
+		},
+	},
+	"future_grants_in": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  "Lists all privileges that will be granted on new objects created in the schema or database.",
+		ExactlyOneOf: []string{"grants_on", "grants_to", "grants_of", "future_grants_in"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"schema": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the schema to list all privileges of new (future) objects granted to. Must be qualified as db.schema.",
+				},
+				"database": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the database to list all privileges of new (future) objects granted to.",
+				},
+			},
+		},
+	},
+	"grants": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The list of grants returned by the query.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"created_on":   {Type: schema.TypeString, Computed: true},
+				"privilege":    {Type: schema.TypeString, Computed: true},
+				"granted_on":   {Type: schema.TypeString, Computed: true},
+				"name":         {Type: schema.TypeString, Computed: true},
+				"granted_to":   {Type: schema.TypeString, Computed: true},
+				"grantee_name": {Type: schema.TypeString, Computed: true},
+				"grant_option": {Type: schema.TypeBool, Computed: true},
+				"granted_by":   {Type: schema.TypeString, Computed: true},
+			},
+		},
+	},
+}
+
+// Grants returns a pointer to the resource representing a grants data source.
+func Grants() *schema.Resource {
+	return &schema.Resource{
+		Read:   ReadGrants,
+		Schema: grantsSchema,
+	}
+}
+
+// ReadGrants implements schema.ReadFunc.
+func ReadGrants(d *schema.ResourceData, meta interface{}) error {
+	db := meta.(*sql.DB)
+
+	query, id, err := grantsQueryFromResourceData(d)
+	if err != nil {
+		return err
+	}
+
+	rows, err := db.Query(query.Show())
+	if err != nil {
+		return err
+	}
+
+	if query.RoleMembership() {
+		roleGrants, err := snowflake.ScanRoleGrants(rows)
+		if err != nil {
+			return err
+		}
+		d.SetId(id)
+		return d.Set("grants", flattenRoleGrants(roleGrants))
+	}
+
+	grants, err := snowflake.ScanGrants(rows)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+	return d.Set("grants", flattenGrants(grants))
+}
+
+// grantsQueryFromResourceData inspects which of the mutually exclusive filter
+// blocks was set and returns the matching snowflake.GrantsQuery, along with a
+// deterministic id for the data source instance.
+func grantsQueryFromResourceData(d *schema.ResourceData) (*snowflake.GrantsQuery, string, error) {
+	if v, ok := d.GetOk("grants_on"); ok {
+		on := v.([]interface{})[0].(map[string]interface{})
+		objectType := on["object_type"].(string)
+		objectName := on["object_name"].(string)
+		if objectType == "ACCOUNT" {
+			return snowflake.GrantsOnAccount(), fmt.Sprintf("grants_on|%v|", objectType), nil
+		}
+		if objectName == "" {
+			return nil, "", fmt.Errorf("grants_on.object_name is required unless object_type is ACCOUNT")
+		}
+		return snowflake.GrantsOn(snowflake.GrantType(objectType), quoteQualifiedName(objectName)), fmt.Sprintf("grants_on|%v|%v", objectType, objectName), nil
+	}
+
+	if v, ok := d.GetOk("grants_to"); ok {
+		to := v.([]interface{})[0].(map[string]interface{})
+		granteeType := to["grantee_type"].(string)
+		granteeName := to["grantee_name"].(string)
+		return snowflake.GrantsTo(snowflake.GranteeType(granteeType), quotedGranteeIdentifier(granteeType, granteeName)), fmt.Sprintf("grants_to|%v|%v", granteeType, granteeName), nil
+	}
+
+	if v, ok := d.GetOk("grants_of"); ok {
+		of := v.([]interface{})[0].(map[string]interface{})
+		granteeType := of["grantee_type"].(string)
+		granteeName := of["grantee_name"].(string)
+		return snowflake.GrantsOf(snowflake.GranteeType(granteeType), quotedGranteeIdentifier(granteeType, granteeName)), fmt.Sprintf("grants_of|%v|%v", granteeType, granteeName), nil
+	}
+
+	if v, ok := d.GetOk("future_grants_in"); ok {
+		in := v.([]interface{})[0].(map[string]interface{})
+		if schemaName, ok := in["schema"].(string); ok && schemaName != "" {
+			return snowflake.FutureGrantsIn(snowflake.GrantType("SCHEMA"), quoteQualifiedName(schemaName)), fmt.Sprintf("future_grants_in|schema|%v", schemaName), nil
+		}
+		if databaseName, ok := in["database"].(string); ok && databaseName != "" {
+			return snowflake.FutureGrantsIn(snowflake.GrantType("DATABASE"), fmt.Sprintf(`"%v"`, databaseName)), fmt.Sprintf("future_grants_in|database|%v", databaseName), nil
+		}
+		return nil, "", fmt.Errorf("future_grants_in requires either schema or database to be set")
+	}
+
+	return nil, "", fmt.Errorf("one of grants_on, grants_to, grants_of, future_grants_in must be set")
+}
+
+func flattenGrants(grants []snowflake.Grant) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(grants))
+	for _, g := range grants {
+		flattened = append(flattened, map[string]interface{}{
+			"created_on":   g.CreatedOn,
+			"privilege":    g.Privilege,
+			"granted_on":   g.GrantedOn,
+			"name":         g.Name,
+			"granted_to":   g.GrantedTo,
+			"grantee_name": g.GranteeName,
+			"grant_option": g.GrantOption,
+			"granted_by":   g.GrantedBy,
+		})
+	}
+	return flattened
+}
+
+// flattenRoleGrants adapts the narrower RoleGrant shape (see
+// snowflake.ScanRoleGrants) onto the "grants" schema, which models the wider
+// privilege-grant shape. Role is surfaced as name; the privilege, granted_on,
+// and grant_option columns this shape doesn't have are left at their zero
+// values.
+func flattenRoleGrants(grants []snowflake.RoleGrant) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(grants))
+	for _, g := range grants {
+		flattened = append(flattened, map[string]interface{}{
+			"created_on":   g.CreatedOn,
+			"privilege":    "",
+			"granted_on":   "",
+			"name":         g.Role,
+			"granted_to":   g.GrantedTo,
+			"grantee_name": g.GranteeName,
+			"grant_option": false,
+			"granted_by":   g.GrantedBy,
+		})
+	}
+	return flattened
+}
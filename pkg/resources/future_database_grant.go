@@ -0,0 +1,188 @@
+package resources
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/bennylu2/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var futureDatabaseGrantSchema = map[string]*schema.Schema{
+	"database_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the database on which to grant future privileges.",
+	},
+	"object_type": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The object type of the future objects to grant privileges on, e.g. TABLE, VIEW, STAGE.",
+	},
+	"privilege": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The privilege to grant on the future objects, e.g. SELECT, USAGE.",
+	},
+	"roles": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Grants future privileges to these roles.",
+	},
+	"shares": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Grants future privileges to these shares.",
+	},
+	"with_grant_option": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     false,
+		Description: "When this is set to true, allows the recipient role to grant the privileges to other roles.",
+	},
+}
+
+// FutureDatabaseGrant returns a pointer to the resource representing a future database grant.
+func FutureDatabaseGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateFutureDatabaseGrant,
+		Read:   ReadFutureDatabaseGrant,
+		Delete: DeleteFutureDatabaseGrant,
+
+		Schema: futureDatabaseGrantSchema,
+	}
+}
+
+// CreateFutureDatabaseGrant implements schema.CreateFunc.
+func CreateFutureDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+	var (
+		databaseName = d.Get("database_name").(string)
+		objectType   = d.Get("object_type").(string)
+		privilege    = d.Get("privilege").(string)
+		roles        = expandStringSet(d.Get("roles").(*schema.Set))
+		shares       = expandStringSet(d.Get("shares").(*schema.Set))
+		grantOption  = d.Get("with_grant_option").(bool)
+	)
+
+	if len(roles)+len(shares) == 0 {
+		return fmt.Errorf("no roles or shares specified for future database grant")
+	}
+
+	builder := snowflake.FutureDatabaseGrant(databaseName, snowflake.GrantType(objectType))
+	db := meta.(*sql.DB)
+
+	for _, role := range roles {
+		if _, err := db.Exec(builder.Role(role).Grant(privilege, grantOption)); err != nil {
+			return err
+		}
+	}
+	for _, share := range shares {
+		if _, err := db.Exec(builder.Share(share).Grant(privilege, grantOption)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%v|%v|%v", databaseName, objectType, privilege))
+	return ReadFutureDatabaseGrant(d, meta)
+}
+
+// ReadFutureDatabaseGrant implements schema.ReadFunc.
+func ReadFutureDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+	databaseName, objectType, privilege, err := splitFutureDatabaseGrantID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	db := meta.(*sql.DB)
+	rows, err := db.Query(snowflake.FutureDatabaseGrant(databaseName, snowflake.GrantType(objectType)).Show())
+	if err != nil {
+		return err
+	}
+
+	grants, err := snowflake.ScanGrants(rows)
+	if err != nil {
+		return err
+	}
+
+	var roles, shares []string
+	grantOption := false
+	for _, g := range grants {
+		if g.Privilege != privilege || g.GrantedOn != objectType {
+			continue
+		}
+		switch g.GrantedTo {
+		case "ROLE":
+			roles = append(roles, g.GranteeName)
+		case "SHARE":
+			shares = append(shares, g.GranteeName)
+		}
+		grantOption = grantOption || g.GrantOption
+	}
+
+	if err := d.Set("database_name", databaseName); err != nil {
+		return err
+	}
+	if err := d.Set("object_type", objectType); err != nil {
+		return err
+	}
+	if err := d.Set("privilege", privilege); err != nil {
+		return err
+	}
+	if err := d.Set("roles", roles); err != nil {
+		return err
+	}
+	if err := d.Set("shares", shares); err != nil {
+		return err
+	}
+	return d.Set("with_grant_option", grantOption)
+}
+
+// DeleteFutureDatabaseGrant implements schema.DeleteFunc.
+func DeleteFutureDatabaseGrant(d *schema.ResourceData, meta interface{}) error {
+	databaseName, objectType, privilege, err := splitFutureDatabaseGrantID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	builder := snowflake.FutureDatabaseGrant(databaseName, snowflake.GrantType(objectType))
+	db := meta.(*sql.DB)
+
+	for _, role := range expandStringSet(d.Get("roles").(*schema.Set)) {
+		if _, err := db.Exec(builder.Role(role).Revoke(privilege)); err != nil {
+			return err
+		}
+	}
+	for _, share := range expandStringSet(d.Get("shares").(*schema.Set)) {
+		if _, err := db.Exec(builder.Share(share).Revoke(privilege)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func splitFutureDatabaseGrantID(id string) (databaseName, objectType, privilege string, err error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("unexpected future database grant id %q, expected database|object_type|privilege", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func expandStringSet(s *schema.Set) []string {
+	out := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		out = append(out, v.(string))
+	}
+	return out
+}
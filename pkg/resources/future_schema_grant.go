@@ -0,0 +1,190 @@
+package resources
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/bennylu2/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var futureSchemaGrantSchema = map[string]*schema.Schema{
+	"database_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the database containing the schema on which to grant future privileges.",
+	},
+	"schema_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the schema on which to grant future privileges.",
+	},
+	"object_type": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The object type of the future objects to grant privileges on, e.g. TABLE, VIEW, STAGE.",
+	},
+	"privilege": {
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The privilege to grant on the future objects, e.g. SELECT, USAGE.",
+	},
+	"roles": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Grants future privileges to these roles.",
+	},
+	"shares": {
+		Type:        schema.TypeSet,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Grants future privileges to these shares.",
+	},
+	"with_grant_option": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		ForceNew:    true,
+		Default:     false,
+		Description: "When this is set to true, allows the recipient role to grant the privileges to other roles.",
+	},
+}
+
+// FutureSchemaGrant returns a pointer to the resource representing a future schema grant.
+func FutureSchemaGrant() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateFutureSchemaGrant,
+		Read:   ReadFutureSchemaGrant,
+		Delete: DeleteFutureSchemaGrant,
+
+		Schema: futureSchemaGrantSchema,
+	}
+}
+
+// CreateFutureSchemaGrant implements schema.CreateFunc.
+func CreateFutureSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+	var (
+		databaseName = d.Get("database_name").(string)
+		schemaName   = d.Get("schema_name").(string)
+		objectType   = d.Get("object_type").(string)
+		privilege    = d.Get("privilege").(string)
+		roles        = expandStringSet(d.Get("roles").(*schema.Set))
+		shares       = expandStringSet(d.Get("shares").(*schema.Set))
+		grantOption  = d.Get("with_grant_option").(bool)
+	)
+
+	if len(roles)+len(shares) == 0 {
+		return fmt.Errorf("no roles or shares specified for future schema grant")
+	}
+
+	builder := snowflake.FutureSchemaGrant(databaseName, schemaName, snowflake.GrantType(objectType))
+	db := meta.(*sql.DB)
+
+	for _, role := range roles {
+		if _, err := db.Exec(builder.Role(role).Grant(privilege, grantOption)); err != nil {
+			return err
+		}
+	}
+	for _, share := range shares {
+		if _, err := db.Exec(builder.Share(share).Grant(privilege, grantOption)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%v|%v|%v|%v", databaseName, schemaName, objectType, privilege))
+	return ReadFutureSchemaGrant(d, meta)
+}
+
+// ReadFutureSchemaGrant implements schema.ReadFunc.
+func ReadFutureSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+	databaseName, schemaName, objectType, privilege, err := splitFutureSchemaGrantID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	db := meta.(*sql.DB)
+	rows, err := db.Query(snowflake.FutureSchemaGrant(databaseName, schemaName, snowflake.GrantType(objectType)).Show())
+	if err != nil {
+		return err
+	}
+
+	grants, err := snowflake.ScanGrants(rows)
+	if err != nil {
+		return err
+	}
+
+	var roles, shares []string
+	grantOption := false
+	for _, g := range grants {
+		if g.Privilege != privilege || g.GrantedOn != objectType {
+			continue
+		}
+		switch g.GrantedTo {
+		case "ROLE":
+			roles = append(roles, g.GranteeName)
+		case "SHARE":
+			shares = append(shares, g.GranteeName)
+		}
+		grantOption = grantOption || g.GrantOption
+	}
+
+	if err := d.Set("database_name", databaseName); err != nil {
+		return err
+	}
+	if err := d.Set("schema_name", schemaName); err != nil {
+		return err
+	}
+	if err := d.Set("object_type", objectType); err != nil {
+		return err
+	}
+	if err := d.Set("privilege", privilege); err != nil {
+		return err
+	}
+	if err := d.Set("roles", roles); err != nil {
+		return err
+	}
+	if err := d.Set("shares", shares); err != nil {
+		return err
+	}
+	return d.Set("with_grant_option", grantOption)
+}
+
+// DeleteFutureSchemaGrant implements schema.DeleteFunc.
+func DeleteFutureSchemaGrant(d *schema.ResourceData, meta interface{}) error {
+	databaseName, schemaName, objectType, privilege, err := splitFutureSchemaGrantID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	builder := snowflake.FutureSchemaGrant(databaseName, schemaName, snowflake.GrantType(objectType))
+	db := meta.(*sql.DB)
+
+	for _, role := range expandStringSet(d.Get("roles").(*schema.Set)) {
+		if _, err := db.Exec(builder.Role(role).Revoke(privilege)); err != nil {
+			return err
+		}
+	}
+	for _, share := range expandStringSet(d.Get("shares").(*schema.Set)) {
+		if _, err := db.Exec(builder.Share(share).Revoke(privilege)); err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func splitFutureSchemaGrantID(id string) (databaseName, schemaName, objectType, privilege string, err error) {
+	parts := strings.Split(id, "|")
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("unexpected future schema grant id %q, expected database|schema|object_type|privilege", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
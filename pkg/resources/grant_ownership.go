@@ -0,0 +1,188 @@
+package resources
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/bennylu2/terraform-provider-snowflake/pkg/snowflake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var grantOwnershipSchema = map[string]*schema.Schema{
+	"on": {
+		Type:        schema.TypeList,
+		Required:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: "Specifies the object on which ownership will be transferred.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_name": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Name of the object to transfer ownership of.",
+				},
+				"object_type": {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "Type of the object to transfer ownership of, e.g. DATABASE, SCHEMA, TABLE.",
+				},
+			},
+		},
+	},
+	"to_role_name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The role to transfer ownership to.",
+	},
+	"outbound_privileges": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      string(snowflake.CopyCurrentGrants),
+		ValidateFunc: validation.StringInSlice([]string{string(snowflake.CopyCurrentGrants), string(snowflake.RevokeCurrentGrants)}, false),
+		Description:  "Specifies whether to remove or copy existing outbound privileges on the object when ownership is transferred. Valid values are COPY CURRENT GRANTS or REVOKE CURRENT GRANTS.",
+	},
+	"revert_ownership_to_role_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The role to transfer ownership of the object back to on destroy. If unset, ownership is left in place when this resource is destroyed.",
+	},
+}
+
+// GrantOwnership returns a pointer to the resource representing a grant ownership resource.
+func GrantOwnership() *schema.Resource {
+	return &schema.Resource{
+		Create: CreateGrantOwnership,
+		Read:   ReadGrantOwnership,
+		Update: UpdateGrantOwnership,
+		Delete: DeleteGrantOwnership,
+
+		Schema: grantOwnershipSchema,
+	}
+}
+
+// CreateGrantOwnership implements schema.CreateFunc.
+func CreateGrantOwnership(d *schema.ResourceData, meta interface{}) error {
+	on := d.Get("on").([]interface{})[0].(map[string]interface{})
+	objectName := on["object_name"].(string)
+	objectType := on["object_type"].(string)
+	toRoleName := d.Get("to_role_name").(string)
+	outboundPrivileges := snowflake.OutboundPrivileges(d.Get("outbound_privileges").(string))
+
+	builder := snowflake.GrantOwnershipOn(snowflake.GrantType(objectType), quoteQualifiedName(objectName)).OutboundPrivileges(outboundPrivileges)
+
+	db := meta.(*sql.DB)
+	if _, err := db.Exec(builder.Role(toRoleName).Grant()); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%v|%v", objectType, objectName))
+	return ReadGrantOwnership(d, meta)
+}
+
+// ReadGrantOwnership implements schema.ReadFunc.
+func ReadGrantOwnership(d *schema.ResourceData, meta interface{}) error {
+	objectType, objectName, err := splitGrantOwnershipID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	db := meta.(*sql.DB)
+	rows, err := db.Query(snowflake.GrantsOn(snowflake.GrantType(objectType), quoteQualifiedName(objectName)).Show())
+	if err != nil {
+		return err
+	}
+
+	grants, err := snowflake.ScanGrants(rows)
+	if err != nil {
+		return err
+	}
+
+	for _, g := range grants {
+		if g.Privilege != `OWNERSHIP` {
+			continue
+		}
+		return d.Set("to_role_name", g.GranteeName)
+	}
+
+	// The object no longer exists, or no longer has an owner we recognize.
+	d.SetId("")
+	return nil
+}
+
+// UpdateGrantOwnership implements schema.UpdateFunc. Re-pointing to_role_name
+// is the only supported update; it reuses snowflake.Plan to compute the
+// OWNERSHIP transfer from the object's current owner (read fresh off `SHOW
+// GRANTS ON`) to the new to_role_name, rather than assuming the prior state
+// is still accurate.
+func UpdateGrantOwnership(d *schema.ResourceData, meta interface{}) error {
+	objectType, objectName, err := splitGrantOwnershipID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	db := meta.(*sql.DB)
+	rows, err := db.Query(snowflake.GrantsOn(snowflake.GrantType(objectType), quoteQualifiedName(objectName)).Show())
+	if err != nil {
+		return err
+	}
+	current, err := snowflake.ScanGrants(rows)
+	if err != nil {
+		return err
+	}
+
+	toRoleName := d.Get("to_role_name").(string)
+	desired := []snowflake.Grant{{Privilege: "OWNERSHIP", GrantedTo: "ROLE", GranteeName: toRoleName}}
+
+	outboundPrivileges := snowflake.OutboundPrivileges(d.Get("outbound_privileges").(string))
+	builder := snowflake.GrantOwnershipOn(snowflake.GrantType(objectType), quoteQualifiedName(objectName)).OutboundPrivileges(outboundPrivileges)
+
+	for _, action := range snowflake.Plan(current, desired) {
+		if action.Type != snowflake.GrantActionTypeGrant || action.Privilege != `OWNERSHIP` {
+			continue
+		}
+		if _, err := db.Exec(builder.Role(action.GranteeName).Grant()); err != nil {
+			return err
+		}
+	}
+
+	return ReadGrantOwnership(d, meta)
+}
+
+// DeleteGrantOwnership implements schema.DeleteFunc.
+func DeleteGrantOwnership(d *schema.ResourceData, meta interface{}) error {
+	revertToRoleName := d.Get("revert_ownership_to_role_name").(string)
+	if revertToRoleName == "" {
+		d.SetId("")
+		return nil
+	}
+
+	objectType, objectName, err := splitGrantOwnershipID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	builder := snowflake.GrantOwnershipOn(snowflake.GrantType(objectType), quoteQualifiedName(objectName)).
+		RevertOwnershipToRoleName(revertToRoleName)
+
+	db := meta.(*sql.DB)
+	if _, err := db.Exec(builder.Role(revertToRoleName).Revert()); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func splitGrantOwnershipID(id string) (objectType, objectName string, err error) {
+	parts := strings.SplitN(id, "|", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("unexpected grant ownership id %q, expected object_type|object_name", id)
+	}
+	return parts[0], parts[1], nil
+}
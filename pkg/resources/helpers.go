@@ -0,0 +1,29 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bennylu2/terraform-provider-snowflake/pkg/snowflake"
+)
+
+// quoteQualifiedName quotes each dot-separated part of a Snowflake object
+// name, e.g. "db.schema.table" -> `"db"."schema"."table"`.
+func quoteQualifiedName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = fmt.Sprintf(`"%v"`, part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// quotedGranteeIdentifier quotes a grantee name for interpolation into a
+// GrantsQuery. Application role names are dot-qualified (app.role) and need
+// each part quoted separately; every other grantee type is a single
+// identifier.
+func quotedGranteeIdentifier(granteeType, name string) string {
+	if granteeType == "APPLICATION ROLE" {
+		return snowflake.QualifiedApplicationRoleName(name)
+	}
+	return fmt.Sprintf(`"%v"`, name)
+}
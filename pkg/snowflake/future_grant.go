@@ -0,0 +1,93 @@
+package snowflake
+
+import (
+	"fmt"
+)
+
+// FutureGrantBuilder abstracts the creation of FutureGrantExecutables for a
+// given object kind, scoped to either an entire database or a single schema.
+type FutureGrantBuilder struct {
+	container     grantType // databaseType or schemaType
+	qualifiedName string
+	objectType    grantType
+}
+
+// FutureDatabaseGrant returns a pointer to a FutureGrantBuilder for future
+// grants of objectType on every matching object created in database db.
+func FutureDatabaseGrant(db string, objectType grantType) *FutureGrantBuilder {
+	return &FutureGrantBuilder{
+		container:     databaseType,
+		qualifiedName: fmt.Sprintf(`"%v"`, db),
+		objectType:    objectType,
+	}
+}
+
+// FutureSchemaGrant returns a pointer to a FutureGrantBuilder for future
+// grants of objectType on every matching object created in schema db.schema.
+func FutureSchemaGrant(db, schema string, objectType grantType) *FutureGrantBuilder {
+	return &FutureGrantBuilder{
+		container:     schemaType,
+		qualifiedName: fmt.Sprintf(`"%v"."%v"`, db, schema),
+		objectType:    objectType,
+	}
+}
+
+// Show returns the SQL that will show all future grants for the container.
+func (fb *FutureGrantBuilder) Show() string {
+	return FutureGrantsIn(fb.container, fb.qualifiedName).Show()
+}
+
+// Role returns a pointer to a FutureGrantExecutable for a role.
+func (fb *FutureGrantBuilder) Role(n string) *FutureGrantExecutable {
+	return &FutureGrantExecutable{
+		container:     fb.container,
+		qualifiedName: fb.qualifiedName,
+		objectType:    fb.objectType,
+		granteeName:   n,
+		granteeType:   roleType,
+	}
+}
+
+// Share returns a pointer to a FutureGrantExecutable for a share.
+func (fb *FutureGrantBuilder) Share(n string) *FutureGrantExecutable {
+	return &FutureGrantExecutable{
+		container:     fb.container,
+		qualifiedName: fb.qualifiedName,
+		objectType:    fb.objectType,
+		granteeName:   n,
+		granteeType:   shareType,
+	}
+}
+
+// FutureGrantExecutable abstracts the creation of SQL queries to grant or
+// revoke future privileges for a specific grantee.
+type FutureGrantExecutable struct {
+	container     grantType
+	qualifiedName string
+	objectType    grantType
+	granteeName   string
+	granteeType   granteeType
+}
+
+// Grant returns the SQL that will grant future privileges on the container to the grantee.
+func (fe *FutureGrantExecutable) Grant(p string, w bool) string {
+	var template string
+	if w {
+		template = `GRANT %v ON FUTURE %vS IN %v %v TO %v "%v" WITH GRANT OPTION`
+	} else {
+		template = `GRANT %v ON FUTURE %vS IN %v %v TO %v "%v"`
+	}
+	return fmt.Sprintf(template,
+		p, fe.objectType, fe.container, fe.qualifiedName, fe.granteeType, fe.granteeName)
+}
+
+// Revoke returns the SQL that will revoke future privileges on the container from the grantee.
+func (fe *FutureGrantExecutable) Revoke(p string) string {
+	return fmt.Sprintf(`REVOKE %v ON FUTURE %vS IN %v %v FROM %v "%v"`,
+		p, fe.objectType, fe.container, fe.qualifiedName, fe.granteeType, fe.granteeName)
+}
+
+// Show returns the SQL that will show all future grants for the container.
+func (fe *FutureGrantExecutable) Show() string {
+	return FutureGrantsIn(fe.container, fe.qualifiedName).Show()
+}
@@ -0,0 +1,32 @@
+package snowflake
+
+import "testing"
+
+func TestFutureGrantExecutableGrant(t *testing.T) {
+	fe := FutureSchemaGrant("mydb", "myschema", tableType).Role("analyst")
+
+	if got, want := fe.Grant("SELECT", false), `GRANT SELECT ON FUTURE TABLES IN SCHEMA "mydb"."myschema" TO ROLE "analyst"`; got != want {
+		t.Errorf("Grant() = %q, want %q", got, want)
+	}
+	if got, want := fe.Grant("SELECT", true), `GRANT SELECT ON FUTURE TABLES IN SCHEMA "mydb"."myschema" TO ROLE "analyst" WITH GRANT OPTION`; got != want {
+		t.Errorf("Grant() with grant option = %q, want %q", got, want)
+	}
+}
+
+func TestFutureGrantExecutableRevoke(t *testing.T) {
+	fe := FutureDatabaseGrant("mydb", viewType).Share("myshare")
+
+	got := fe.Revoke("SELECT")
+	want := `REVOKE SELECT ON FUTURE VIEWS IN DATABASE "mydb" FROM SHARE "myshare"`
+	if got != want {
+		t.Errorf("Revoke() = %q, want %q", got, want)
+	}
+}
+
+func TestFutureGrantBuilderShow(t *testing.T) {
+	got := FutureSchemaGrant("mydb", "myschema", tableType).Show()
+	want := `SHOW FUTURE GRANTS IN SCHEMA "mydb"."myschema"`
+	if got != want {
+		t.Errorf("Show() = %q, want %q", got, want)
+	}
+}
@@ -28,6 +28,18 @@ const (
 	streamType           grantType = "STREAM"
 )
 
+// GrantType converts a string (e.g. a value read off Terraform resource data)
+// into the grantType understood by the builders in this package.
+func GrantType(s string) grantType {
+	return grantType(s)
+}
+
+// GranteeType converts a string (e.g. a value read off Terraform resource
+// data) into the granteeType understood by the builders in this package.
+func GranteeType(s string) granteeType {
+	return granteeType(s)
+}
+
 type GrantExecutable interface {
 	Grant(p string, w bool) string
 	Revoke(p string) string
@@ -39,6 +51,9 @@ type GrantBuilder interface {
 	GrantType() string
 	Role(string) GrantExecutable
 	Share(string) GrantExecutable
+	User(string) (GrantExecutable, error)
+	Application(string) GrantExecutable
+	ApplicationRole(string) GrantExecutable
 	Show() string
 }
 
@@ -106,6 +121,34 @@ func (gb *CurrentMaterializedViewGrantBuilder) Share(n string) GrantExecutable {
 	}
 }
 
+// User always returns an error: Snowflake does not allow object privileges
+// to be granted directly to a user. A user gains access to a materialized
+// view by being granted a role that holds the privilege, which this package
+// does not model.
+func (gb *CurrentMaterializedViewGrantBuilder) User(n string) (GrantExecutable, error) {
+	return nil, fmt.Errorf("cannot grant privileges on %v %v directly to user %q; grant to a role instead and grant that role to the user", gb.grantType, gb.qualifiedName, n)
+}
+
+// Application returns a pointer to a CurrentGrantExecutable for an application
+func (gb *CurrentMaterializedViewGrantBuilder) Application(n string) GrantExecutable {
+	return &CurrentGrantExecutable{
+		grantName:   gb.qualifiedName,
+		grantType:   viewType,
+		granteeName: n,
+		granteeType: applicationType,
+	}
+}
+
+// ApplicationRole returns a pointer to a CurrentGrantExecutable for an application role
+func (gb *CurrentMaterializedViewGrantBuilder) ApplicationRole(n string) GrantExecutable {
+	return &CurrentGrantExecutable{
+		grantName:   gb.qualifiedName,
+		grantType:   viewType,
+		granteeName: QualifiedApplicationRoleName(n),
+		granteeType: applicationRoleType,
+	}
+}
+
 ///////////////////////////////////////////////
 /// END CurrentMaterializedViewGrantBuilder ///
 ///////////////////////////////////////////////
@@ -255,9 +298,11 @@ func StreamGrant(db, schema, stream string) GrantBuilder {
 type granteeType string
 
 const (
-	roleType  granteeType = "ROLE"
-	shareType granteeType = "SHARE"
-	userType  granteeType = "USER" // user is only supported for RoleGrants.
+	roleType            granteeType = "ROLE"
+	shareType           granteeType = "SHARE"
+	userType            granteeType = "USER" // user is only supported for RoleGrants.
+	applicationType     granteeType = "APPLICATION"
+	applicationRoleType granteeType = "APPLICATION ROLE"
 )
 
 // CurrentGrantExecutable abstracts the creation of SQL queries to build grants for
@@ -289,27 +334,80 @@ func (gb *CurrentGrantBuilder) Share(n string) GrantExecutable {
 	}
 }
 
+// User always returns an error: Snowflake does not allow object privileges
+// to be granted directly to a user. A user gains access to a current grant's
+// object by being granted a role that holds the privilege, which this
+// package does not model.
+func (gb *CurrentGrantBuilder) User(n string) (GrantExecutable, error) {
+	return nil, fmt.Errorf("cannot grant privileges on %v %v directly to user %q; grant to a role instead and grant that role to the user", gb.grantType, gb.qualifiedName, n)
+}
+
+// Application returns a pointer to a CurrentGrantExecutable for an application
+func (gb *CurrentGrantBuilder) Application(n string) GrantExecutable {
+	return &CurrentGrantExecutable{
+		grantName:   gb.qualifiedName,
+		grantType:   gb.grantType,
+		granteeName: n,
+		granteeType: applicationType,
+	}
+}
+
+// ApplicationRole returns a pointer to a CurrentGrantExecutable for an
+// application role. n is the dot-qualified role name, e.g. "my_app.my_role".
+func (gb *CurrentGrantBuilder) ApplicationRole(n string) GrantExecutable {
+	return &CurrentGrantExecutable{
+		grantName:   gb.qualifiedName,
+		grantType:   gb.grantType,
+		granteeName: QualifiedApplicationRoleName(n),
+		granteeType: applicationRoleType,
+	}
+}
+
+// QualifiedApplicationRoleName double-quotes each dot-separated part of an
+// application role name, e.g. "my_app.my_role" -> `"my_app"."my_role"`. It is
+// exported so that callers outside this package (such as the grants data
+// source) can format an application role grantee the same way the grant
+// builders in this file do.
+func QualifiedApplicationRoleName(n string) string {
+	parts := strings.Split(n, ".")
+	for i, part := range parts {
+		parts[i] = fmt.Sprintf(`"%v"`, part)
+	}
+	return strings.Join(parts, ".")
+}
+
 // Grant returns the SQL that will grant privileges on the grant to the grantee
 func (ge *CurrentGrantExecutable) Grant(p string, w bool) string {
 	var template string
 	if p == `OWNERSHIP` {
-		template = `GRANT %v ON %v %v TO %v "%v" COPY CURRENT GRANTS`
+		template = `GRANT %v ON %v %v TO %v %v COPY CURRENT GRANTS`
 	} else if w {
-		template = `GRANT %v ON %v %v TO %v "%v" WITH GRANT OPTION`
+		template = `GRANT %v ON %v %v TO %v %v WITH GRANT OPTION`
 	} else {
-		template = `GRANT %v ON %v %v TO %v "%v"`
+		template = `GRANT %v ON %v %v TO %v %v`
 	}
 	return fmt.Sprintf(template,
-		p, ge.grantType, ge.grantName, ge.granteeType, ge.granteeName)
+		p, ge.grantType, ge.grantName, ge.granteeType, ge.quotedGranteeName())
 }
 
 // Revoke returns the SQL that will revoke privileges on the grant from the grantee
 func (ge *CurrentGrantExecutable) Revoke(p string) string {
-	return fmt.Sprintf(`REVOKE %v ON %v %v FROM %v "%v"`,
-		p, ge.grantType, ge.grantName, ge.granteeType, ge.granteeName)
+	return fmt.Sprintf(`REVOKE %v ON %v %v FROM %v %v`,
+		p, ge.grantType, ge.grantName, ge.granteeType, ge.quotedGranteeName())
 }
 
 // Show returns the SQL that will show all grants of the grantee
 func (ge *CurrentGrantExecutable) Show() string {
-	return fmt.Sprintf(`SHOW GRANTS OF %v "%v"`, ge.granteeType, ge.granteeName)
+	return fmt.Sprintf(`SHOW GRANTS OF %v %v`, ge.granteeType, ge.quotedGranteeName())
+}
+
+// quotedGranteeName returns the grantee name ready to be interpolated into
+// SQL. Application role names are already dot-qualified and quoted by
+// QualifiedApplicationRoleName; every other grantee type is a single
+// identifier that still needs quoting here.
+func (ge *CurrentGrantExecutable) quotedGranteeName() string {
+	if ge.granteeType == applicationRoleType {
+		return ge.granteeName
+	}
+	return fmt.Sprintf(`"%v"`, ge.granteeName)
 }
@@ -0,0 +1,91 @@
+package snowflake
+
+import "fmt"
+
+// OutboundPrivileges controls what happens to the privileges other roles
+// already hold on an object when ownership of that object is transferred
+// away from its current owner.
+type OutboundPrivileges string
+
+const (
+	CopyCurrentGrants   OutboundPrivileges = "COPY CURRENT GRANTS"
+	RevokeCurrentGrants OutboundPrivileges = "REVOKE CURRENT GRANTS"
+)
+
+// GrantOwnershipBuilder builds the SQL to transfer ownership of an object to
+// a role, and to revert that transfer back to a known-good role on destroy.
+type GrantOwnershipBuilder struct {
+	grantType          grantType
+	qualifiedName      string
+	outboundPrivileges OutboundPrivileges
+	revertToRoleName   string
+}
+
+// GrantOwnershipOn returns a pointer to a GrantOwnershipBuilder for the given
+// object. objectType follows the same vocabulary as CurrentGrantBuilder, e.g.
+// "DATABASE", "TABLE", "SCHEMA".
+func GrantOwnershipOn(objectType grantType, qualifiedName string) *GrantOwnershipBuilder {
+	return &GrantOwnershipBuilder{
+		grantType:          objectType,
+		qualifiedName:      qualifiedName,
+		outboundPrivileges: CopyCurrentGrants,
+	}
+}
+
+// OutboundPrivileges sets whether the privileges other roles hold on the
+// object are copied onto the new owner or revoked. Defaults to
+// CopyCurrentGrants.
+func (b *GrantOwnershipBuilder) OutboundPrivileges(p OutboundPrivileges) *GrantOwnershipBuilder {
+	b.outboundPrivileges = p
+	return b
+}
+
+// RevertOwnershipToRoleName configures the role ownership is transferred back
+// to when the resulting GrantOwnershipExecutable's Revert SQL is run.
+func (b *GrantOwnershipBuilder) RevertOwnershipToRoleName(name string) *GrantOwnershipBuilder {
+	b.revertToRoleName = name
+	return b
+}
+
+// Role returns a pointer to a GrantOwnershipExecutable transferring ownership
+// to role n.
+func (b *GrantOwnershipBuilder) Role(n string) *GrantOwnershipExecutable {
+	return &GrantOwnershipExecutable{
+		grantType:          b.grantType,
+		qualifiedName:      b.qualifiedName,
+		granteeName:        n,
+		outboundPrivileges: b.outboundPrivileges,
+		revertToRoleName:   b.revertToRoleName,
+	}
+}
+
+// GrantOwnershipExecutable abstracts the creation of SQL queries to transfer
+// and revert ownership of a single object.
+type GrantOwnershipExecutable struct {
+	grantType          grantType
+	qualifiedName      string
+	granteeName        string
+	outboundPrivileges OutboundPrivileges
+	revertToRoleName   string
+}
+
+// Grant returns the SQL that will transfer ownership of the object to the
+// configured role.
+func (ge *GrantOwnershipExecutable) Grant() string {
+	return fmt.Sprintf(`GRANT OWNERSHIP ON %v %v TO ROLE "%v" %v`,
+		ge.grantType, ge.qualifiedName, ge.granteeName, ge.outboundPrivileges)
+}
+
+// Revert returns the SQL that will transfer ownership of the object back to
+// RevertOwnershipToRoleName. It always copies current grants forward so that
+// whatever the relinquishing owner granted to other roles survives the
+// rollback.
+func (ge *GrantOwnershipExecutable) Revert() string {
+	return fmt.Sprintf(`GRANT OWNERSHIP ON %v %v TO ROLE "%v" %v`,
+		ge.grantType, ge.qualifiedName, ge.revertToRoleName, CopyCurrentGrants)
+}
+
+// Show returns the SQL that will show the current owner of the object.
+func (ge *GrantOwnershipExecutable) Show() string {
+	return GrantsOn(ge.grantType, ge.qualifiedName).Show()
+}
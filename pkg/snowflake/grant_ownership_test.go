@@ -0,0 +1,38 @@
+package snowflake
+
+import "testing"
+
+func TestGrantOwnershipExecutableGrant(t *testing.T) {
+	ge := GrantOwnershipOn(tableType, `"mydb"."myschema"."mytable"`).Role("new_owner")
+
+	got := ge.Grant()
+	want := `GRANT OWNERSHIP ON TABLE "mydb"."myschema"."mytable" TO ROLE "new_owner" COPY CURRENT GRANTS`
+	if got != want {
+		t.Errorf("Grant() = %q, want %q", got, want)
+	}
+}
+
+func TestGrantOwnershipExecutableGrantWithRevokeCurrentGrants(t *testing.T) {
+	ge := GrantOwnershipOn(tableType, `"mydb"."myschema"."mytable"`).
+		OutboundPrivileges(RevokeCurrentGrants).
+		Role("new_owner")
+
+	got := ge.Grant()
+	want := `GRANT OWNERSHIP ON TABLE "mydb"."myschema"."mytable" TO ROLE "new_owner" REVOKE CURRENT GRANTS`
+	if got != want {
+		t.Errorf("Grant() = %q, want %q", got, want)
+	}
+}
+
+func TestGrantOwnershipExecutableRevertAlwaysCopiesCurrentGrants(t *testing.T) {
+	ge := GrantOwnershipOn(tableType, `"mydb"."myschema"."mytable"`).
+		OutboundPrivileges(RevokeCurrentGrants).
+		RevertOwnershipToRoleName("original_owner").
+		Role("new_owner")
+
+	got := ge.Revert()
+	want := `GRANT OWNERSHIP ON TABLE "mydb"."myschema"."mytable" TO ROLE "original_owner" COPY CURRENT GRANTS`
+	if got != want {
+		t.Errorf("Revert() = %q, want %q", got, want)
+	}
+}
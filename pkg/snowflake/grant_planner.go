@@ -0,0 +1,182 @@
+package snowflake
+
+import "sort"
+
+// GrantActionType distinguishes a GRANT from a REVOKE within a grant
+// reconciliation plan.
+type GrantActionType string
+
+const (
+	GrantActionTypeGrant  GrantActionType = "GRANT"
+	GrantActionTypeRevoke GrantActionType = "REVOKE"
+)
+
+// GrantAction is a single step of a plan computed by Plan. Resources turn
+// each GrantAction into SQL via the existing GrantExecutable/GrantOwnership
+// builders rather than building SQL here directly.
+type GrantAction struct {
+	Type            GrantActionType
+	Privilege       string
+	GranteeType     string
+	GranteeName     string
+	WithGrantOption bool
+
+	// CopyCurrentGrants is only ever set on an OWNERSHIP GrantActionTypeGrant;
+	// it records that the transfer must be executed with COPY CURRENT GRANTS
+	// rather than REVOKE CURRENT GRANTS.
+	CopyCurrentGrants bool
+}
+
+type grantKey struct {
+	privilege   string
+	granteeType string
+	granteeName string
+}
+
+func keyOf(g Grant) grantKey {
+	return grantKey{privilege: g.Privilege, granteeType: g.GrantedTo, granteeName: g.GranteeName}
+}
+
+// Plan computes the minimal ordered list of GrantActions needed to reconcile
+// an object's privileges from current (the parsed result of a `SHOW GRANTS`)
+// to desired (the set of (privilege, grantee, with_grant_option) tuples a
+// resource wants): additions for tuples in desired but not current, revokes
+// for tuples in current but not desired, and a revoke followed by a re-grant
+// when only WithGrantOption flips, since Snowflake has no ALTER GRANT. The
+// result is sorted by (privilege, grantee type, grantee name) so that callers
+// (and tests) see a stable, reproducible order regardless of map iteration.
+//
+// OWNERSHIP is handled separately from every other privilege: when the owner
+// changes, Plan emits a single GrantAction with CopyCurrentGrants set instead
+// of a revoke/grant pair, since `GRANT OWNERSHIP ... COPY CURRENT GRANTS`
+// already transfers ownership away from the previous owner and preserves
+// whatever the previous and new owner already held on the object. Plan
+// therefore suppresses revokes against the previous and new owner that the
+// COPY would just undo.
+func Plan(current []Grant, desired []Grant) []GrantAction {
+	var actions []GrantAction
+
+	ownershipAction, changingOwnership := planOwnershipChange(current, desired)
+	var oldOwner, newOwner grantKey
+	if changingOwnership {
+		actions = append(actions, ownershipAction)
+		newOwner = grantKey{granteeType: ownershipAction.GranteeType, granteeName: ownershipAction.GranteeName}
+		for _, g := range current {
+			if g.Privilege == `OWNERSHIP` {
+				oldOwner = grantKey{granteeType: g.GrantedTo, granteeName: g.GranteeName}
+				break
+			}
+		}
+	}
+
+	currentByKey := map[grantKey]Grant{}
+	for _, g := range current {
+		if g.Privilege == `OWNERSHIP` {
+			continue
+		}
+		currentByKey[keyOf(g)] = g
+	}
+
+	desiredByKey := map[grantKey]Grant{}
+	for _, g := range desired {
+		if g.Privilege == `OWNERSHIP` {
+			continue
+		}
+		desiredByKey[keyOf(g)] = g
+	}
+
+	for key, want := range desiredByKey {
+		have, exists := currentByKey[key]
+		switch {
+		case !exists:
+			actions = append(actions, grantAction(want))
+		case have.GrantOption != want.GrantOption:
+			actions = append(actions, revokeAction(have), grantAction(want))
+		}
+	}
+
+	for key, have := range currentByKey {
+		if _, exists := desiredByKey[key]; exists {
+			continue
+		}
+		haveOwner := grantKey{granteeType: have.GrantedTo, granteeName: have.GranteeName}
+		if changingOwnership && (haveOwner == oldOwner || haveOwner == newOwner) {
+			// COPY CURRENT GRANTS retains whatever the previous and new
+			// owner already held on this object; revoking it here would
+			// just be undone by the copy, so skip it.
+			continue
+		}
+		actions = append(actions, revokeAction(have))
+	}
+
+	sort.SliceStable(actions, func(i, j int) bool {
+		a, b := actions[i], actions[j]
+		if a.Privilege != b.Privilege {
+			return a.Privilege < b.Privilege
+		}
+		if a.GranteeType != b.GranteeType {
+			return a.GranteeType < b.GranteeType
+		}
+		if a.GranteeName != b.GranteeName {
+			return a.GranteeName < b.GranteeName
+		}
+		// Within the same tuple, a revoke (clearing a stale grant option)
+		// must run before its matching re-grant.
+		return a.Type == GrantActionTypeRevoke && b.Type == GrantActionTypeGrant
+	})
+
+	return actions
+}
+
+// planOwnershipChange returns the single GrantAction needed to move
+// OWNERSHIP to a new grantee, or ok=false if desired has no OWNERSHIP tuple
+// or it already matches the current owner.
+func planOwnershipChange(current, desired []Grant) (action GrantAction, ok bool) {
+	var oldOwner, newOwner *Grant
+	for i := range current {
+		if current[i].Privilege == `OWNERSHIP` {
+			oldOwner = &current[i]
+			break
+		}
+	}
+	for i := range desired {
+		if desired[i].Privilege == `OWNERSHIP` {
+			newOwner = &desired[i]
+			break
+		}
+	}
+
+	if newOwner == nil {
+		return GrantAction{}, false
+	}
+	if oldOwner != nil && oldOwner.GrantedTo == newOwner.GrantedTo && oldOwner.GranteeName == newOwner.GranteeName {
+		return GrantAction{}, false
+	}
+
+	return GrantAction{
+		Type:              GrantActionTypeGrant,
+		Privilege:         `OWNERSHIP`,
+		GranteeType:       newOwner.GrantedTo,
+		GranteeName:       newOwner.GranteeName,
+		CopyCurrentGrants: true,
+	}, true
+}
+
+func grantAction(g Grant) GrantAction {
+	return GrantAction{
+		Type:            GrantActionTypeGrant,
+		Privilege:       g.Privilege,
+		GranteeType:     g.GrantedTo,
+		GranteeName:     g.GranteeName,
+		WithGrantOption: g.GrantOption,
+	}
+}
+
+func revokeAction(g Grant) GrantAction {
+	return GrantAction{
+		Type:        GrantActionTypeRevoke,
+		Privilege:   g.Privilege,
+		GranteeType: g.GrantedTo,
+		GranteeName: g.GranteeName,
+	}
+}
@@ -0,0 +1,146 @@
+package snowflake
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanAddsMissingGrants(t *testing.T) {
+	current := []Grant{
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "ANALYST"},
+	}
+	desired := []Grant{
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "ANALYST"},
+		{Privilege: "INSERT", GrantedTo: "ROLE", GranteeName: "ANALYST"},
+	}
+
+	got := Plan(current, desired)
+	want := []GrantAction{
+		{Type: GrantActionTypeGrant, Privilege: "INSERT", GranteeType: "ROLE", GranteeName: "ANALYST"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlanRevokesExtraGrants(t *testing.T) {
+	current := []Grant{
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "ANALYST"},
+		{Privilege: "INSERT", GrantedTo: "ROLE", GranteeName: "ANALYST"},
+	}
+	desired := []Grant{
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "ANALYST"},
+	}
+
+	got := Plan(current, desired)
+	want := []GrantAction{
+		{Type: GrantActionTypeRevoke, Privilege: "INSERT", GranteeType: "ROLE", GranteeName: "ANALYST"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlanRegrantsOnGrantOptionFlip(t *testing.T) {
+	current := []Grant{
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "ANALYST", GrantOption: false},
+	}
+	desired := []Grant{
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "ANALYST", GrantOption: true},
+	}
+
+	got := Plan(current, desired)
+	want := []GrantAction{
+		{Type: GrantActionTypeRevoke, Privilege: "SELECT", GranteeType: "ROLE", GranteeName: "ANALYST"},
+		{Type: GrantActionTypeGrant, Privilege: "SELECT", GranteeType: "ROLE", GranteeName: "ANALYST", WithGrantOption: true},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlanIsOrderedDeterministically(t *testing.T) {
+	current := []Grant{}
+	desired := []Grant{
+		{Privilege: "USAGE", GrantedTo: "ROLE", GranteeName: "ZETA"},
+		{Privilege: "SELECT", GrantedTo: "SHARE", GranteeName: "ALPHA"},
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "BETA"},
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "ALPHA"},
+	}
+
+	want := []GrantAction{
+		{Type: GrantActionTypeGrant, Privilege: "SELECT", GranteeType: "ROLE", GranteeName: "ALPHA"},
+		{Type: GrantActionTypeGrant, Privilege: "SELECT", GranteeType: "ROLE", GranteeName: "BETA"},
+		{Type: GrantActionTypeGrant, Privilege: "SELECT", GranteeType: "SHARE", GranteeName: "ALPHA"},
+		{Type: GrantActionTypeGrant, Privilege: "USAGE", GranteeType: "ROLE", GranteeName: "ZETA"},
+	}
+
+	for i := 0; i < 5; i++ {
+		got := Plan(current, desired)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Plan() iteration %d = %+v, want %+v", i, got, want)
+		}
+	}
+}
+
+func TestPlanOwnershipChangeSuppressesRevokesUndoneByCopy(t *testing.T) {
+	current := []Grant{
+		{Privilege: "OWNERSHIP", GrantedTo: "ROLE", GranteeName: "OLD_OWNER"},
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "OLD_OWNER"},
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "NEW_OWNER"},
+		{Privilege: "SELECT", GrantedTo: "ROLE", GranteeName: "ANALYST"},
+	}
+	desired := []Grant{
+		{Privilege: "OWNERSHIP", GrantedTo: "ROLE", GranteeName: "NEW_OWNER"},
+	}
+
+	got := Plan(current, desired)
+	want := []GrantAction{
+		{Type: GrantActionTypeGrant, Privilege: "OWNERSHIP", GranteeType: "ROLE", GranteeName: "NEW_OWNER", CopyCurrentGrants: true},
+		{Type: GrantActionTypeRevoke, Privilege: "SELECT", GranteeType: "ROLE", GranteeName: "ANALYST"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlanOwnershipChangeSuppressionIsKeyedOnGranteeTypeToo(t *testing.T) {
+	// A SHARE happens to share a name with the role that is becoming the new
+	// owner. Only the ROLE grant should be suppressed; the SHARE grant is a
+	// different grantee and must still be revoked.
+	current := []Grant{
+		{Privilege: "OWNERSHIP", GrantedTo: "ROLE", GranteeName: "OLD_OWNER"},
+		{Privilege: "SELECT", GrantedTo: "SHARE", GranteeName: "NEW_OWNER"},
+	}
+	desired := []Grant{
+		{Privilege: "OWNERSHIP", GrantedTo: "ROLE", GranteeName: "NEW_OWNER"},
+	}
+
+	got := Plan(current, desired)
+	want := []GrantAction{
+		{Type: GrantActionTypeGrant, Privilege: "OWNERSHIP", GranteeType: "ROLE", GranteeName: "NEW_OWNER", CopyCurrentGrants: true},
+		{Type: GrantActionTypeRevoke, Privilege: "SELECT", GranteeType: "SHARE", GranteeName: "NEW_OWNER"},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Plan() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlanOwnershipUnchangedIsNotReplanned(t *testing.T) {
+	current := []Grant{
+		{Privilege: "OWNERSHIP", GrantedTo: "ROLE", GranteeName: "OWNER"},
+	}
+	desired := []Grant{
+		{Privilege: "OWNERSHIP", GrantedTo: "ROLE", GranteeName: "OWNER"},
+	}
+
+	got := Plan(current, desired)
+	if len(got) != 0 {
+		t.Errorf("Plan() = %+v, want no actions", got)
+	}
+}
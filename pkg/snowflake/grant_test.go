@@ -0,0 +1,82 @@
+package snowflake
+
+import "testing"
+
+func TestCurrentGrantExecutableGrant(t *testing.T) {
+	ge := TableGrant("mydb", "myschema", "mytable").Role("analyst")
+
+	if got, want := ge.Grant("SELECT", false), `GRANT SELECT ON TABLE "mydb"."myschema"."mytable" TO ROLE "analyst"`; got != want {
+		t.Errorf("Grant() = %q, want %q", got, want)
+	}
+	if got, want := ge.Grant("SELECT", true), `GRANT SELECT ON TABLE "mydb"."myschema"."mytable" TO ROLE "analyst" WITH GRANT OPTION`; got != want {
+		t.Errorf("Grant() with grant option = %q, want %q", got, want)
+	}
+}
+
+func TestCurrentGrantExecutableGrantOwnershipUsesCopyCurrentGrants(t *testing.T) {
+	ge := TableGrant("mydb", "myschema", "mytable").Role("new_owner")
+
+	got := ge.Grant("OWNERSHIP", false)
+	want := `GRANT OWNERSHIP ON TABLE "mydb"."myschema"."mytable" TO ROLE "new_owner" COPY CURRENT GRANTS`
+	if got != want {
+		t.Errorf("Grant() = %q, want %q", got, want)
+	}
+}
+
+func TestCurrentGrantExecutableRevoke(t *testing.T) {
+	ge := TableGrant("mydb", "myschema", "mytable").Share("myshare")
+
+	got := ge.Revoke("SELECT")
+	want := `REVOKE SELECT ON TABLE "mydb"."myschema"."mytable" FROM SHARE "myshare"`
+	if got != want {
+		t.Errorf("Revoke() = %q, want %q", got, want)
+	}
+}
+
+func TestCurrentGrantExecutableApplicationRoleIsDotQualified(t *testing.T) {
+	ge := TableGrant("mydb", "myschema", "mytable").ApplicationRole("my_app.my_role")
+
+	got := ge.Grant("SELECT", false)
+	want := `GRANT SELECT ON TABLE "mydb"."myschema"."mytable" TO APPLICATION ROLE "my_app"."my_role"`
+	if got != want {
+		t.Errorf("Grant() = %q, want %q", got, want)
+	}
+}
+
+func TestCurrentGrantExecutableApplication(t *testing.T) {
+	ge := TableGrant("mydb", "myschema", "mytable").Application("my_app")
+
+	got := ge.Grant("SELECT", false)
+	want := `GRANT SELECT ON TABLE "mydb"."myschema"."mytable" TO APPLICATION "my_app"`
+	if got != want {
+		t.Errorf("Grant() = %q, want %q", got, want)
+	}
+}
+
+func TestCurrentGrantBuilderUserIsUnsupported(t *testing.T) {
+	if _, err := TableGrant("mydb", "myschema", "mytable").User("someuser"); err == nil {
+		t.Error("User() = nil error, want an error rejecting the grant")
+	}
+}
+
+func TestCurrentMaterializedViewGrantBuilderUserIsUnsupported(t *testing.T) {
+	if _, err := MaterializedViewGrant("mydb", "myschema", "myview").User("someuser"); err == nil {
+		t.Error("User() = nil error, want an error rejecting the grant")
+	}
+}
+
+func TestQualifiedApplicationRoleName(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{in: "my_role", want: `"my_role"`},
+		{in: "my_app.my_role", want: `"my_app"."my_role"`},
+	}
+
+	for _, c := range cases {
+		if got := QualifiedApplicationRoleName(c.in); got != c.want {
+			t.Errorf("QualifiedApplicationRoleName(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package snowflake
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// GrantsQuery builds the various forms of `SHOW GRANTS` / `SHOW FUTURE GRANTS`
+// supported by Snowflake. Unlike CurrentGrantBuilder, which is scoped to a
+// single object and grantee, GrantsQuery is a read-only query builder meant
+// for enumerating existing grants.
+type GrantsQuery struct {
+	clause         string
+	future         bool
+	roleMembership bool
+}
+
+// GrantsOnAccount returns a GrantsQuery for `SHOW GRANTS ON ACCOUNT`.
+func GrantsOnAccount() *GrantsQuery {
+	return &GrantsQuery{clause: "ON ACCOUNT"}
+}
+
+// GrantsOn returns a GrantsQuery for `SHOW GRANTS ON <objectType> <qualifiedName>`.
+func GrantsOn(objectType grantType, qualifiedName string) *GrantsQuery {
+	return &GrantsQuery{clause: fmt.Sprintf(`ON %v %v`, objectType, qualifiedName)}
+}
+
+// GrantsTo returns a GrantsQuery for `SHOW GRANTS TO <granteeType> <qualifiedName>`.
+// Granting TO a USER or APPLICATION returns Snowflake's role-membership row
+// shape (see RoleGrant) rather than the privilege-grant shape returned for
+// ROLE/SHARE/APPLICATION ROLE; RoleMembership reports which shape to expect.
+func GrantsTo(grantee granteeType, qualifiedName string) *GrantsQuery {
+	return &GrantsQuery{
+		clause:         fmt.Sprintf(`TO %v %v`, grantee, qualifiedName),
+		roleMembership: grantee == userType || grantee == applicationType,
+	}
+}
+
+// GrantsOf returns a GrantsQuery for `SHOW GRANTS OF <granteeType> <qualifiedName>`.
+// Asking OF a ROLE or APPLICATION ROLE returns Snowflake's role-membership row
+// shape (see RoleGrant); RoleMembership reports which shape to expect.
+func GrantsOf(grantee granteeType, qualifiedName string) *GrantsQuery {
+	return &GrantsQuery{
+		clause:         fmt.Sprintf(`OF %v %v`, grantee, qualifiedName),
+		roleMembership: grantee == roleType || grantee == applicationRoleType,
+	}
+}
+
+// FutureGrantsIn returns a GrantsQuery for `SHOW FUTURE GRANTS IN {SCHEMA|DATABASE} <qualifiedName>`.
+// scope must be either databaseType or schemaType.
+func FutureGrantsIn(scope grantType, qualifiedName string) *GrantsQuery {
+	return &GrantsQuery{clause: fmt.Sprintf(`IN %v %v`, scope, qualifiedName), future: true}
+}
+
+// Show returns the SQL that will execute the query.
+func (q *GrantsQuery) Show() string {
+	if q.future {
+		return fmt.Sprintf(`SHOW FUTURE GRANTS %v`, q.clause)
+	}
+	return fmt.Sprintf(`SHOW GRANTS %v`, q.clause)
+}
+
+// RoleMembership reports whether this query returns Snowflake's
+// role-membership row shape (see RoleGrant, ScanRoleGrants) rather than the
+// privilege-grant shape (see Grant, ScanGrants). Callers must check this
+// before deciding which scan function to use.
+func (q *GrantsQuery) RoleMembership() bool {
+	return q.roleMembership
+}
+
+// Grant represents a single row returned by a `SHOW GRANTS` / `SHOW FUTURE GRANTS` query.
+type Grant struct {
+	CreatedOn   string
+	Privilege   string
+	GrantedOn   string
+	Name        string
+	GrantedTo   string
+	GranteeName string
+	GrantOption bool
+	GrantedBy   string
+}
+
+// ScanGrants reads the result set of a GrantsQuery into a slice of Grant. The
+// caller is responsible for executing the query; ScanGrants consumes and
+// closes rows.
+func ScanGrants(rows *sql.Rows) ([]Grant, error) {
+	defer rows.Close()
+
+	var grants []Grant
+	for rows.Next() {
+		g := Grant{}
+		if err := rows.Scan(
+			&g.CreatedOn,
+			&g.Privilege,
+			&g.GrantedOn,
+			&g.Name,
+			&g.GrantedTo,
+			&g.GranteeName,
+			&g.GrantOption,
+			&g.GrantedBy,
+		); err != nil {
+			return nil, err
+		}
+		grants = append(grants, g)
+	}
+
+	return grants, rows.Err()
+}
+
+// RoleGrant represents a single row returned by the narrower "role
+// membership" forms of SHOW GRANTS: SHOW GRANTS OF ROLE, SHOW GRANTS OF
+// APPLICATION ROLE, SHOW GRANTS TO USER, and SHOW GRANTS TO APPLICATION.
+// These forms describe who holds a role rather than what privileges an
+// object grants, so they return created_on, role, granted_to, grantee_name,
+// granted_by instead of the 8-column Grant shape. Use GrantsQuery.RoleMembership
+// to tell which shape a given query will return before scanning its rows.
+type RoleGrant struct {
+	CreatedOn   string
+	Role        string
+	GrantedTo   string
+	GranteeName string
+	GrantedBy   string
+}
+
+// ScanRoleGrants reads the result set of a role-membership GrantsQuery (see
+// RoleGrant) into a slice of RoleGrant. The caller is responsible for
+// executing the query; ScanRoleGrants consumes and closes rows.
+func ScanRoleGrants(rows *sql.Rows) ([]RoleGrant, error) {
+	defer rows.Close()
+
+	var grants []RoleGrant
+	for rows.Next() {
+		g := RoleGrant{}
+		if err := rows.Scan(
+			&g.CreatedOn,
+			&g.Role,
+			&g.GrantedTo,
+			&g.GranteeName,
+			&g.GrantedBy,
+		); err != nil {
+			return nil, err
+		}
+		grants = append(grants, g)
+	}
+
+	return grants, rows.Err()
+}
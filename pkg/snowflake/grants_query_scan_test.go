@@ -0,0 +1,93 @@
+package snowflake
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestScanGrants(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() err = %v", err)
+	}
+	defer db.Close()
+
+	columns := []string{"created_on", "privilege", "granted_on", "name", "granted_to", "grantee_name", "grant_option", "granted_by"}
+	mock.ExpectQuery(`SHOW GRANTS ON TABLE "mydb"."myschema"."mytable"`).WillReturnRows(
+		sqlmock.NewRows(columns).
+			AddRow("2020-01-01", "SELECT", "TABLE", `"mydb"."myschema"."mytable"`, "ROLE", "ANALYST", false, "SYSADMIN"),
+	)
+
+	rows, err := db.Query(`SHOW GRANTS ON TABLE "mydb"."myschema"."mytable"`)
+	if err != nil {
+		t.Fatalf("db.Query() err = %v", err)
+	}
+
+	got, err := ScanGrants(rows)
+	if err != nil {
+		t.Fatalf("ScanGrants() err = %v", err)
+	}
+
+	want := []Grant{
+		{
+			CreatedOn:   "2020-01-01",
+			Privilege:   "SELECT",
+			GrantedOn:   "TABLE",
+			Name:        `"mydb"."myschema"."mytable"`,
+			GrantedTo:   "ROLE",
+			GranteeName: "ANALYST",
+			GrantOption: false,
+			GrantedBy:   "SYSADMIN",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ScanGrants() = %+v, want %+v", got, want)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestScanRoleGrants(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() err = %v", err)
+	}
+	defer db.Close()
+
+	columns := []string{"created_on", "role", "granted_to", "grantee_name", "granted_by"}
+	mock.ExpectQuery(`SHOW GRANTS OF ROLE "analyst"`).WillReturnRows(
+		sqlmock.NewRows(columns).
+			AddRow("2020-01-01", "ANALYST", "USER", "JDOE", "SYSADMIN"),
+	)
+
+	rows, err := db.Query(`SHOW GRANTS OF ROLE "analyst"`)
+	if err != nil {
+		t.Fatalf("db.Query() err = %v", err)
+	}
+
+	got, err := ScanRoleGrants(rows)
+	if err != nil {
+		t.Fatalf("ScanRoleGrants() err = %v", err)
+	}
+
+	want := []RoleGrant{
+		{
+			CreatedOn:   "2020-01-01",
+			Role:        "ANALYST",
+			GrantedTo:   "USER",
+			GranteeName: "JDOE",
+			GrantedBy:   "SYSADMIN",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ScanRoleGrants() = %+v, want %+v", got, want)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
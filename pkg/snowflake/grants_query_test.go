@@ -0,0 +1,76 @@
+package snowflake
+
+import "testing"
+
+func TestGrantsQueryShow(t *testing.T) {
+	cases := []struct {
+		name  string
+		query *GrantsQuery
+		want  string
+	}{
+		{
+			name:  "on account",
+			query: GrantsOnAccount(),
+			want:  `SHOW GRANTS ON ACCOUNT`,
+		},
+		{
+			name:  "on object",
+			query: GrantsOn(databaseType, `"mydb"`),
+			want:  `SHOW GRANTS ON DATABASE "mydb"`,
+		},
+		{
+			name:  "to grantee",
+			query: GrantsTo(roleType, `"analyst"`),
+			want:  `SHOW GRANTS TO ROLE "analyst"`,
+		},
+		{
+			name:  "of grantee",
+			query: GrantsOf(roleType, `"analyst"`),
+			want:  `SHOW GRANTS OF ROLE "analyst"`,
+		},
+		{
+			name:  "future grants in schema",
+			query: FutureGrantsIn(schemaType, `"mydb"."myschema"`),
+			want:  `SHOW FUTURE GRANTS IN SCHEMA "mydb"."myschema"`,
+		},
+		{
+			name:  "future grants in database",
+			query: FutureGrantsIn(databaseType, `"mydb"`),
+			want:  `SHOW FUTURE GRANTS IN DATABASE "mydb"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.query.Show(); got != c.want {
+				t.Errorf("Show() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestGrantsQueryRoleMembership(t *testing.T) {
+	cases := []struct {
+		name  string
+		query *GrantsQuery
+		want  bool
+	}{
+		{name: "to role", query: GrantsTo(roleType, `"analyst"`), want: false},
+		{name: "to share", query: GrantsTo(shareType, `"myshare"`), want: false},
+		{name: "to application role", query: GrantsTo(applicationRoleType, `"my_app"."my_role"`), want: false},
+		{name: "to user", query: GrantsTo(userType, `"jdoe"`), want: true},
+		{name: "to application", query: GrantsTo(applicationType, `"my_app"`), want: true},
+		{name: "of role", query: GrantsOf(roleType, `"analyst"`), want: true},
+		{name: "of application role", query: GrantsOf(applicationRoleType, `"my_app"."my_role"`), want: true},
+		{name: "of share", query: GrantsOf(shareType, `"myshare"`), want: false},
+		{name: "on object", query: GrantsOn(databaseType, `"mydb"`), want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := c.query.RoleMembership(); got != c.want {
+				t.Errorf("RoleMembership() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}